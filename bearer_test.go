@@ -0,0 +1,45 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestInitWithBearerTokenStripsAPIKey ensures a v4 bearer-token client
+// sends the Authorization header and strips api_key from the request
+// URL instead of sending both forms of auth.
+func TestInitWithBearerTokenStripsAPIKey(t *testing.T) {
+	var gotAuth string
+	var gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotQuery = r.URL.RawQuery
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := InitWithBearerToken("read-access-token")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var data map[string]interface{}
+	requestURL := server.URL + "?api_key=should-be-stripped&language=en-US"
+	if err := c.get(nil, requestURL, &data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if gotAuth != "Bearer read-access-token" {
+		t.Fatalf("expected Authorization header %q, got %q", "Bearer read-access-token", gotAuth)
+	}
+
+	if gotQuery != "language=en-US" {
+		t.Fatalf("expected api_key to be stripped, got query %q", gotQuery)
+	}
+}
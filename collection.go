@@ -0,0 +1,24 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+// CollectionDetails represents a collection's detail payload as
+// returned by the /collection/{collection_id} endpoint. It's the
+// decode target for GetCollectionBatch.
+type CollectionDetails struct {
+	ID           int64   `json:"id"`
+	Name         string  `json:"name"`
+	Overview     string  `json:"overview"`
+	PosterPath   string  `json:"poster_path"`
+	BackdropPath string  `json:"backdrop_path"`
+	Parts        []Movie `json:"parts"`
+}
+
+// Movie is the condensed movie payload embedded in list-style
+// responses such as CollectionDetails.Parts.
+type Movie struct {
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	PosterPath  string `json:"poster_path"`
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestUseMiddlewareOrder ensures middlewares registered via Use run in
+// registration order, with the first one added seeing the request
+// first (outermost in the chain).
+func TestUseMiddlewareOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var order []string
+
+	c := &Client{apiKey: "key"}
+	c.Use(func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			order = append(order, "first")
+			return next(req)
+		}
+	})
+	c.Use(func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			order = append(order, "second")
+			return next(req)
+		}
+	})
+
+	var data map[string]interface{}
+	if err := c.get(nil, server.URL, &data); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Fatalf("expected middleware order [first second], got %v", order)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+// MovieDetails represents a movie's detail payload as returned by the
+// /movie/{movie_id} endpoint. It's the decode target for GetMoviesBatch.
+type MovieDetails struct {
+	ID               int64   `json:"id"`
+	IMDbID           string  `json:"imdb_id"`
+	Title            string  `json:"title"`
+	OriginalTitle    string  `json:"original_title"`
+	OriginalLanguage string  `json:"original_language"`
+	Overview         string  `json:"overview"`
+	Tagline          string  `json:"tagline"`
+	Status           string  `json:"status"`
+	ReleaseDate      string  `json:"release_date"`
+	Runtime          int     `json:"runtime"`
+	PosterPath       string  `json:"poster_path"`
+	BackdropPath     string  `json:"backdrop_path"`
+	Popularity       float32 `json:"popularity"`
+	VoteAverage      float32 `json:"vote_average"`
+	VoteCount        int64   `json:"vote_count"`
+	Adult            bool    `json:"adult"`
+	Video            bool    `json:"video"`
+}
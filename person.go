@@ -0,0 +1,22 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+// PersonDetails represents a person's detail payload as returned by the
+// /person/{person_id} endpoint. It's the decode target for
+// GetPersonBatch.
+type PersonDetails struct {
+	ID                 int64    `json:"id"`
+	IMDbID             string   `json:"imdb_id"`
+	Name               string   `json:"name"`
+	Biography          string   `json:"biography"`
+	Birthday           string   `json:"birthday"`
+	Deathday           string   `json:"deathday"`
+	PlaceOfBirth       string   `json:"place_of_birth"`
+	Gender             int      `json:"gender"`
+	KnownForDepartment string   `json:"known_for_department"`
+	AlsoKnownAs        []string `json:"also_known_as"`
+	ProfilePath        string   `json:"profile_path"`
+	Popularity         float32  `json:"popularity"`
+	Adult              bool     `json:"adult"`
+}
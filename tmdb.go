@@ -10,15 +10,22 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
+// baseURL is a var rather than a const so tests can point it at an
+// httptest.Server instead of the real TMDb API.
+var baseURL = "https://api.themoviedb.org/3"
+
 // TMDb constants
 const (
-	baseURL           = "https://api.themoviedb.org/3"
 	permissionURL     = "https://www.themoviedb.org/authenticate/"
 	authenticationURL = "/authentication/"
 	movieURL          = "/movie/"
@@ -46,13 +53,111 @@ type Client struct {
 	apiKey string
 	// sessionId to use the client.
 	sessionID string
+	// accessToken is a TMDB v4 read-access token sent via the
+	// Authorization header. When set, it is used instead of the v3
+	// ?api_key= query parameter for reads; sessionID can still be set
+	// alongside it to authenticate v3 write endpoints (/account, /list,
+	// ...), which don't yet have a v4 equivalent.
+	accessToken string
 	// Auto retry flag to indicates if the client
 	// should retry the previous operation.
 	autoRetry bool
-	// withContext flag enables the request with context.
-	withContext bool
 	// http.Client for custom configuration.
 	http http.Client
+	// limiter throttles outgoing requests to stay under TMDb's rate limit.
+	limiter *rate.Limiter
+	// retryPolicy configures how failed requests are retried. A nil
+	// retryPolicy falls back to defaultRetryPolicy when autoRetry is set.
+	retryPolicy *RetryPolicy
+	// middlewares is the chain of RequestMiddleware installed via Use,
+	// applied in registration order around c.http.Do.
+	middlewares []RequestMiddleware
+	// timeoutOnce ensures the default http.Client timeout is applied
+	// exactly once, even when get/request run concurrently across the
+	// worker goroutines fetchBatch spawns on a shared Client.
+	timeoutOnce sync.Once
+}
+
+// RoundTrip performs a single HTTP round trip. It has the same shape as
+// http.RoundTripper.RoundTrip so middleware can be adapted from, or to,
+// the standard library.
+type RoundTrip func(req *http.Request) (*http.Response, error)
+
+// RequestMiddleware wraps a RoundTrip with additional behavior, such as
+// metrics, structured logging, tracing, response caching or custom
+// auth. Middleware call next to continue the chain; returning without
+// calling next short-circuits the request (useful for a cache hit).
+type RequestMiddleware func(next RoundTrip) RoundTrip
+
+// Use appends mw to the client's middleware chain. Middlewares wrap the
+// innermost RoundTrip (c.http.Do) in the order they were registered, so
+// the first middleware added is the outermost one to see the request.
+func (c *Client) Use(mw RequestMiddleware) {
+	c.middlewares = append(c.middlewares, mw)
+}
+
+// roundTrip sends req through the configured middleware chain, falling
+// back to a plain c.http.Do when no middleware has been installed.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	rt := RoundTrip(c.http.Do)
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+
+	return rt(req)
+}
+
+// RetryPolicy configures how a Client retries failed requests.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retry attempts before giving up.
+	MaxRetries int
+	// MinDelay is the base backoff delay for the first retry; with jitter
+	// the actual wait falls between MinDelay/2 and MinDelay.
+	MinDelay time.Duration
+	// MaxDelay caps the exponential backoff delay between retries. A
+	// Retry-After header sent by the server is honored as given and is
+	// not capped by MaxDelay.
+	MaxDelay time.Duration
+	// RetryableStatusCodes lists the HTTP status codes that should be
+	// retried, in addition to network-level errors.
+	RetryableStatusCodes map[int]bool
+}
+
+// defaultRetryPolicy is used whenever autoRetry is enabled but the
+// caller hasn't configured a custom RetryPolicy.
+func defaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 5,
+		MinDelay:   time.Second,
+		MaxDelay:   time.Second * 30,
+		RetryableStatusCodes: map[int]bool{
+			http.StatusTooManyRequests:     true,
+			http.StatusAccepted:            true,
+			http.StatusInternalServerError: true,
+			http.StatusBadGateway:          true,
+			http.StatusServiceUnavailable:  true,
+			http.StatusGatewayTimeout:      true,
+		},
+	}
+}
+
+// backoff calculates how long to wait before the next retry attempt,
+// preferring the response's Retry-After header when present and
+// otherwise applying exponential backoff with jitter.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil && resp.Header.Get("Retry-After") != "" {
+		return retryDuration(resp)
+	}
+
+	delay := p.MinDelay * time.Duration(int64(1)<<uint(attempt))
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+
+	return delay/2 + jitter
 }
 
 // Error type represents an error returned by the TMDB API.
@@ -77,6 +182,18 @@ func Init(apiKey string) (*Client, error) {
 	return &Client{apiKey: apiKey}, nil
 }
 
+// InitWithBearerToken sets up a Client using a TMDB v4 read-access
+// token, authenticated via an `Authorization: Bearer` header instead of
+// the v3 `?api_key=` query parameter. Call SetSessionID afterwards if
+// the client also needs to hit v3 write endpoints.
+func InitWithBearerToken(token string) (*Client, error) {
+	if token == "" {
+		return nil, errors.New("AccessToken is empty")
+	}
+
+	return &Client{accessToken: token}, nil
+}
+
 // SetSessionID will set the session id.
 func (c *Client) SetSessionID(sid string) error {
 	if sid == "" {
@@ -99,8 +216,78 @@ func (c *Client) SetClientAutoRetry() {
 }
 
 // SetClientWithContext enables the request with context.
-func (c *Client) SetClientWithContext() {
-	c.withContext = true
+//
+// Deprecated: every request now takes a context.Context argument (see
+// Client.get and Client.request; a nil one is treated as
+// context.Background()), so this is a no-op kept only so existing
+// callers keep compiling.
+func (c *Client) SetClientWithContext() {}
+
+// SetRateLimit throttles outgoing requests to rps requests per second,
+// allowing bursts of up to burst requests. TMDB documents a default
+// limit of roughly 40 requests per 10 seconds, so callers doing bulk
+// metadata pulls should set this proactively instead of relying on
+// reactive 429 handling alone.
+func (c *Client) SetRateLimit(rps float64, burst int) {
+	c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// SetRetryPolicy configures how the client retries failed requests and
+// implies SetClientAutoRetry.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = &policy
+	c.autoRetry = true
+}
+
+// retryPolicyOrDefault returns the client's configured RetryPolicy, or
+// defaultRetryPolicy when none has been set.
+func (c *Client) retryPolicyOrDefault() *RetryPolicy {
+	if c.retryPolicy != nil {
+		return c.retryPolicy
+	}
+
+	return defaultRetryPolicy()
+}
+
+// waitForRateLimit blocks until the rate limiter admits the next
+// request. It is a no-op when no limiter has been configured.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+
+	return c.limiter.Wait(ctx)
+}
+
+// sleepOrDone waits for delay to elapse, returning early with ctx's
+// error the moment ctx is canceled so an in-progress backoff never
+// outlasts the caller's deadline.
+func sleepOrDone(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stripAPIKey removes the api_key query parameter from rawURL. It is
+// used when a v4 bearer token is configured, since the Authorization
+// header replaces the v3 ?api_key= convention entirely.
+func stripAPIKey(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	query := parsed.Query()
+	query.Del("api_key")
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
 }
 
 // Auto retry default duration.
@@ -123,47 +310,72 @@ func retryDuration(resp *http.Response) time.Duration {
 	return time.Duration(seconds) * time.Second
 }
 
-// shouldRetry determines whether the status code indicates that the
-// previous operation should be retried at a later time.
-func shouldRetry(status int) bool {
-	return status == http.StatusAccepted || status == http.StatusTooManyRequests
-}
-
-func (c *Client) get(url string, data interface{}) error {
+func (c *Client) get(ctx context.Context, url string, data interface{}) error {
 	if url == "" {
 		return errors.New("url field is empty")
 	}
 
-	if c.http.Timeout == 0 {
-		c.http.Timeout = time.Second * 10
+	c.timeoutOnce.Do(func() {
+		if c.http.Timeout == 0 {
+			c.http.Timeout = time.Second * 10
+		}
+	})
+
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	if c.withContext {
-		req, err = http.NewRequestWithContext(
-			context.Background(),
-			http.MethodGet,
-			url,
-			nil,
-		)
+	if c.accessToken != "" {
+		url = stripAPIKey(url)
 	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("could not fetch the url: %s", err)
 	}
 
 	req.Header.Add("content-type", "application/json;charset=utf-8")
 
-	for {
-		res, err := c.http.Do(req)
+	if c.accessToken != "" {
+		req.Header.Add("Authorization", "Bearer "+c.accessToken)
+	}
+
+	policy := c.retryPolicyOrDefault()
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(req.Context()); err != nil {
+			return err
+		}
+
+		res, err := c.roundTrip(req)
 
 		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			if c.autoRetry && attempt < policy.MaxRetries {
+				if sleepErr := sleepOrDone(ctx, policy.backoff(attempt, nil)); sleepErr != nil {
+					return sleepErr
+				}
+
+				continue
+			}
+
 			return err
 		}
 
 		defer res.Body.Close()
 
-		if res.StatusCode == http.StatusTooManyRequests && c.autoRetry {
-			time.Sleep(retryDuration(res))
+		if c.autoRetry && policy.RetryableStatusCodes[res.StatusCode] && attempt < policy.MaxRetries {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			if sleepErr := sleepOrDone(ctx, policy.backoff(attempt, res)); sleepErr != nil {
+				return sleepErr
+			}
+
 			continue
 		}
 
@@ -186,6 +398,7 @@ func (c *Client) get(url string, data interface{}) error {
 }
 
 func (c *Client) request(
+	ctx context.Context,
 	url string,
 	body interface{},
 	method string,
@@ -195,42 +408,74 @@ func (c *Client) request(
 		return errors.New("url field is empty")
 	}
 
-	if c.http.Timeout == 0 {
-		c.http.Timeout = time.Second * 10
+	c.timeoutOnce.Do(func() {
+		if c.http.Timeout == 0 {
+			c.http.Timeout = time.Second * 10
+		}
+	})
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if c.accessToken != "" {
+		url = stripAPIKey(url)
 	}
 
 	bodyBytes := new(bytes.Buffer)
 	json.NewEncoder(bodyBytes).Encode(body)
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		method,
 		url,
 		bytes.NewBuffer(bodyBytes.Bytes()),
 	)
-	if c.withContext {
-		req, err = http.NewRequestWithContext(
-			context.Background(),
-			method,
-			url,
-			bytes.NewBuffer(bodyBytes.Bytes()),
-		)
-	}
 	if err != nil {
 		return fmt.Errorf("could not fetch the url: %s", err)
 	}
 
 	req.Header.Add("content-type", "application/json;charset=utf-8")
 
-	for {
-		res, err := c.http.Do(req)
+	if c.accessToken != "" {
+		req.Header.Add("Authorization", "Bearer "+c.accessToken)
+	}
+
+	policy := c.retryPolicyOrDefault()
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(req.Context()); err != nil {
+			return err
+		}
+
+		res, err := c.roundTrip(req)
 		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			if c.autoRetry && attempt < policy.MaxRetries {
+				if sleepErr := sleepOrDone(ctx, policy.backoff(attempt, nil)); sleepErr != nil {
+					return sleepErr
+				}
+
+				continue
+			}
+
 			return errors.New(err.Error())
 		}
 
 		defer res.Body.Close()
 
-		if c.autoRetry && shouldRetry(res.StatusCode) {
-			time.Sleep(retryDuration(res))
+		if c.autoRetry && policy.RetryableStatusCodes[res.StatusCode] && attempt < policy.MaxRetries {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			if sleepErr := sleepOrDone(ctx, policy.backoff(attempt, res)); sleepErr != nil {
+				return sleepErr
+			}
+
 			continue
 		}
 
@@ -251,22 +496,172 @@ func (c *Client) request(
 	return nil
 }
 
-func (c *Client) fmtOptions(
-	urlOptions map[string]string,
-) string {
-	options := ""
+// batchResult pairs a fetched id with its decoded data or error.
+type batchResult[T any] struct {
+	id   int
+	data *T
+	err  error
+}
+
+// fetchBatch fans out one GET per id under pathPrefix using a bounded
+// worker pool of size concurrency, reusing the client's rate limiter,
+// retry policy and middleware chain for every request, and decoding
+// each response straight into a *T. Remaining work is abandoned as soon
+// as ctx is canceled.
+func fetchBatch[T any](
+	c *Client,
+	ctx context.Context,
+	pathPrefix string,
+	ids []int,
+	options map[string]string,
+	concurrency int,
+) (map[int]*T, map[int]error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	jobs := make(chan int)
+	results := make(chan batchResult[T])
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for id := range jobs {
+				data := new(T)
+
+				params := url.Values{}
+				for key, value := range options {
+					params.Set(key, value)
+				}
+
+				requestURL := c.BuildURL(pathPrefix+strconv.Itoa(id), params)
 
-	if len(urlOptions) > 0 {
-		for key, value := range urlOptions {
-			options += fmt.Sprintf(
-				"&%s=%s",
-				key,
-				url.QueryEscape(value),
-			)
+				err := c.get(ctx, requestURL, data)
+
+				select {
+				case results <- batchResult[T]{id: id, data: data, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+
+		for _, id := range ids {
+			select {
+			case jobs <- id:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	data := make(map[int]*T, len(ids))
+	errs := make(map[int]error)
+
+	for res := range results {
+		if res.err != nil {
+			errs[res.id] = res.err
+			continue
+		}
+
+		data[res.id] = res.data
+	}
+
+	return data, errs
+}
+
+// GetMoviesBatch fetches multiple movies concurrently, see fetchBatch.
+func (c *Client) GetMoviesBatch(
+	ctx context.Context,
+	ids []int,
+	options map[string]string,
+	concurrency int,
+) (map[int]*MovieDetails, map[int]error) {
+	return fetchBatch[MovieDetails](c, ctx, movieURL, ids, options, concurrency)
+}
+
+// GetTVBatch fetches multiple TV shows concurrently, see fetchBatch.
+func (c *Client) GetTVBatch(
+	ctx context.Context,
+	ids []int,
+	options map[string]string,
+	concurrency int,
+) (map[int]*TVDetails, map[int]error) {
+	return fetchBatch[TVDetails](c, ctx, tvURL, ids, options, concurrency)
+}
+
+// GetPersonBatch fetches multiple people concurrently, see fetchBatch.
+func (c *Client) GetPersonBatch(
+	ctx context.Context,
+	ids []int,
+	options map[string]string,
+	concurrency int,
+) (map[int]*PersonDetails, map[int]error) {
+	return fetchBatch[PersonDetails](c, ctx, personURL, ids, options, concurrency)
+}
+
+// GetCollectionBatch fetches multiple collections concurrently, see
+// fetchBatch.
+func (c *Client) GetCollectionBatch(
+	ctx context.Context,
+	ids []int,
+	options map[string]string,
+	concurrency int,
+) (map[int]*CollectionDetails, map[int]error) {
+	return fetchBatch[CollectionDetails](c, ctx, collectionURL, ids, options, concurrency)
+}
+
+// BuildURL constructs a fully-qualified TMDB API URL for path, merging
+// in the client's auth (the v3 api_key query parameter, or nothing when
+// a v4 bearer token is configured) with params. Keys are sorted and
+// properly escaped by url.Values.Encode, so the same logical request
+// always produces the same URL string — useful for custom endpoints,
+// request signing/logging, or an HTTP cache key.
+func (c *Client) BuildURL(path string, params url.Values) string {
+	if params == nil {
+		params = url.Values{}
+	} else {
+		params = cloneValues(params)
+	}
+
+	if c.accessToken == "" {
+		params.Set("api_key", c.apiKey)
+	}
+
+	query := params.Encode()
+	if query == "" {
+		return baseURL + path
+	}
+
+	return baseURL + path + "?" + query
+}
+
+// cloneValues returns a copy of values so BuildURL never mutates the
+// url.Values a caller passed in.
+func cloneValues(values url.Values) url.Values {
+	clone := make(url.Values, len(values))
+	for key, value := range values {
+		clone[key] = append([]string(nil), value...)
 	}
 
-	return options
+	return clone
 }
 
 func (e Error) Error() string {
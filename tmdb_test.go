@@ -0,0 +1,138 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetRetryRespectsContextCancellation ensures a canceled context
+// interrupts an in-progress retry backoff instead of sleeping it out.
+func TestGetRetryRespectsContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := &Client{apiKey: "key"}
+	c.SetRetryPolicy(RetryPolicy{
+		MaxRetries: 5,
+		MinDelay:   time.Second,
+		MaxDelay:   time.Second * 30,
+		RetryableStatusCodes: map[int]bool{
+			http.StatusInternalServerError: true,
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	var data map[string]interface{}
+	err := c.get(ctx, server.URL, &data)
+
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected an error from a canceled context, got nil")
+	}
+
+	if elapsed > time.Second {
+		t.Fatalf("canceled request took %s, expected it to return almost immediately", elapsed)
+	}
+}
+
+// TestFetchBatchNilContext ensures a nil context is normalized to
+// context.Background() instead of reaching ctx.Done() in a worker
+// goroutine and panicking.
+func TestFetchBatchNilContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	originalBaseURL := baseURL
+	baseURL = server.URL
+	defer func() { baseURL = originalBaseURL }()
+
+	c := &Client{apiKey: "key"}
+
+	//lint:ignore SA1012 nil is the case under test.
+	data, errs := c.GetMoviesBatch(nil, []int{1, 2}, nil, 2)
+
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+
+	if len(data) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(data))
+	}
+}
+
+// TestFetchBatchConcurrentTimeoutInit ensures concurrent worker
+// goroutines sharing a Client don't race over the lazy http.Client
+// timeout init in get/request. Run with -race to verify.
+func TestFetchBatchConcurrentTimeoutInit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	originalBaseURL := baseURL
+	baseURL = server.URL
+	defer func() { baseURL = originalBaseURL }()
+
+	c := &Client{apiKey: "key"}
+
+	ids := make([]int, 20)
+	for i := range ids {
+		ids[i] = i
+	}
+
+	data, errs := c.GetMoviesBatch(context.Background(), ids, nil, 10)
+
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+
+	if len(data) != len(ids) {
+		t.Fatalf("expected %d results, got %d", len(ids), len(data))
+	}
+}
+
+// TestGetMoviesBatchTypedDecode ensures batch results decode into the
+// typed MovieDetails struct rather than a generic map.
+func TestGetMoviesBatchTypedDecode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": 550, "title": "Fight Club"}`))
+	}))
+	defer server.Close()
+
+	originalBaseURL := baseURL
+	baseURL = server.URL
+	defer func() { baseURL = originalBaseURL }()
+
+	c := &Client{apiKey: "key"}
+
+	data, errs := c.GetMoviesBatch(context.Background(), []int{550}, nil, 1)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+
+	movie, ok := data[550]
+	if !ok {
+		t.Fatal("expected a result for id 550")
+	}
+
+	if movie.Title != "Fight Club" {
+		t.Fatalf("expected title %q, got %q", "Fight Club", movie.Title)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+// TVDetails represents a TV show's detail payload as returned by the
+// /tv/{tv_id} endpoint. It's the decode target for GetTVBatch.
+type TVDetails struct {
+	ID               int64    `json:"id"`
+	Name             string   `json:"name"`
+	OriginalName     string   `json:"original_name"`
+	OriginalLanguage string   `json:"original_language"`
+	Overview         string   `json:"overview"`
+	Status           string   `json:"status"`
+	FirstAirDate     string   `json:"first_air_date"`
+	LastAirDate      string   `json:"last_air_date"`
+	NumberOfSeasons  int      `json:"number_of_seasons"`
+	NumberOfEpisodes int      `json:"number_of_episodes"`
+	EpisodeRunTime   []int    `json:"episode_run_time"`
+	PosterPath       string   `json:"poster_path"`
+	BackdropPath     string   `json:"backdrop_path"`
+	Popularity       float32  `json:"popularity"`
+	VoteAverage      float32  `json:"vote_average"`
+	VoteCount        int64    `json:"vote_count"`
+	InProduction     bool     `json:"in_production"`
+	OriginCountry    []string `json:"origin_country"`
+}
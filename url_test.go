@@ -0,0 +1,40 @@
+// Copyright (c) 2019 Cyro Dubeux. License MIT.
+
+package tmdb
+
+import (
+	"net/url"
+	"testing"
+)
+
+// TestBuildURL ensures BuildURL merges the client's auth with the given
+// params into a deterministic, correctly-encoded URL, regardless of
+// map iteration order, and omits api_key for bearer-token clients.
+func TestBuildURL(t *testing.T) {
+	c := &Client{apiKey: "key"}
+
+	params := url.Values{"region": {"US/CA"}, "language": {"en-US"}}
+	want := baseURL + "/movie/550?api_key=key&language=en-US&region=US%2FCA"
+
+	for i := 0; i < 10; i++ {
+		if got := c.BuildURL("/movie/550", params); got != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+	}
+
+	if got := c.BuildURL("/movie/550", nil); got != baseURL+"/movie/550?api_key=key" {
+		t.Fatalf("expected api_key-only query for nil params, got %q", got)
+	}
+
+	bearerClient, err := InitWithBearerToken("token")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := bearerClient.BuildURL("/movie/550", nil)
+	want = baseURL + "/movie/550"
+
+	if got != want {
+		t.Fatalf("expected api_key to be omitted for bearer auth, got %q", got)
+	}
+}